@@ -0,0 +1,40 @@
+// Package clock abstracts reading the current time so that callers can
+// inject a deterministic or historical clock instead of the wall clock.
+package clock
+
+import "time"
+
+// Clock is a source of the current time.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+}
+
+// SystemClock is a Clock backed by the real wall clock.
+type SystemClock struct{}
+
+// Now returns the current wall-clock time.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// Since returns the time elapsed since t, as measured by the wall clock.
+func (SystemClock) Since(t time.Time) time.Duration { return time.Since(t) }
+
+// FakeClock is a Clock with a manually controlled time, for tests and
+// replaying historical logs.
+type FakeClock struct {
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock whose current time is now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time { return c.now }
+
+// Since returns the time elapsed between t and the clock's current time.
+func (c *FakeClock) Since(t time.Time) time.Duration { return c.now.Sub(t) }
+
+// Advance moves the clock's current time forward by d.
+func (c *FakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
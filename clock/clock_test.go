@@ -0,0 +1,25 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	if got := c.Now(); !got.Equal(start) {
+		t.Fatalf("Now() = %v, want %v", got, start)
+	}
+
+	c.Advance(5 * time.Minute)
+
+	want := start.Add(5 * time.Minute)
+	if got := c.Now(); !got.Equal(want) {
+		t.Fatalf("Now() after Advance = %v, want %v", got, want)
+	}
+	if got := c.Since(start); got != 5*time.Minute {
+		t.Fatalf("Since(start) = %v, want %v", got, 5*time.Minute)
+	}
+}
@@ -0,0 +1,60 @@
+// Package measure models a single Geiger counter measurement window and
+// derives count rates and dose rates from it.
+package measure
+
+import (
+	"time"
+
+	"github.com/mwuertinger/geiger/clock"
+)
+
+// Session is one measurement window, opened with Start and closed with
+// End. All time reads go through the injected Clock so that sessions can
+// be replayed from historical logs or driven deterministically in tests.
+type Session struct {
+	clock clock.Clock
+	start time.Time
+	end   time.Time
+}
+
+// NewSession returns a Session that reads the current time through c.
+func NewSession(c clock.Clock) *Session {
+	return &Session{clock: c}
+}
+
+// Start opens the session at t.
+func (s *Session) Start(t time.Time) {
+	s.start = t
+	s.end = time.Time{}
+}
+
+// End closes the session at t.
+func (s *Session) End(t time.Time) {
+	s.end = t
+}
+
+// Duration returns the length of the session. If the session has not been
+// closed with End yet, it is measured up to the clock's current time.
+func (s *Session) Duration() time.Duration {
+	if s.end.IsZero() {
+		return s.clock.Since(s.start)
+	}
+	return s.end.Sub(s.start)
+}
+
+// CPM returns counts per minute for counts observed over the session's
+// duration. It returns 0 if the duration is zero.
+func (s *Session) CPM(counts int) float64 {
+	minutes := s.Duration().Minutes()
+	if minutes == 0 {
+		return 0
+	}
+	return float64(counts) / minutes
+}
+
+// DoseRate returns the dose rate for counts observed over the session's
+// duration, converted from CPM using the device-specific factor (dose
+// rate units per CPM).
+func (s *Session) DoseRate(counts int, factor float64) float64 {
+	return s.CPM(counts) * factor
+}
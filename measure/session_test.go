@@ -0,0 +1,71 @@
+package measure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mwuertinger/geiger/clock"
+)
+
+func TestSessionDuration(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+
+	s := NewSession(clock.SystemClock{})
+	s.Start(start)
+	s.End(start.Add(15*time.Minute + 2*time.Second))
+
+	want := 15*time.Minute + 2*time.Second
+	if got := s.Duration(); got != want {
+		t.Errorf("Duration() = %v, want %v", got, want)
+	}
+}
+
+func TestSessionDurationUsesClockWhenOpen(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+	fake := clock.NewFakeClock(start)
+
+	s := NewSession(fake)
+	s.Start(start)
+
+	fake.Advance(90 * time.Second)
+
+	if got, want := s.Duration(), 90*time.Second; got != want {
+		t.Errorf("Duration() = %v, want %v", got, want)
+	}
+}
+
+func TestSessionCPM(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+
+	s := NewSession(clock.SystemClock{})
+	s.Start(start)
+	s.End(start.Add(2 * time.Minute))
+
+	if got, want := s.CPM(120), 60.0; got != want {
+		t.Errorf("CPM(120) = %v, want %v", got, want)
+	}
+}
+
+func TestSessionCPMZeroDuration(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+
+	s := NewSession(clock.SystemClock{})
+	s.Start(start)
+	s.End(start)
+
+	if got, want := s.CPM(10), 0.0; got != want {
+		t.Errorf("CPM(10) = %v, want %v", got, want)
+	}
+}
+
+func TestSessionDoseRate(t *testing.T) {
+	start := time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC)
+
+	s := NewSession(clock.SystemClock{})
+	s.Start(start)
+	s.End(start.Add(2 * time.Minute))
+
+	if got, want := s.DoseRate(120, 0.01), 0.6; got != want {
+		t.Errorf("DoseRate(120, 0.01) = %v, want %v", got, want)
+	}
+}
@@ -1,23 +1,52 @@
 package main
 
 import (
-	"time"
+	"flag"
 	"log"
+
+	"github.com/mwuertinger/geiger/clock"
+	"github.com/mwuertinger/geiger/measure"
+	"github.com/mwuertinger/geiger/timeparse"
 )
 
 func main() {
 	log.SetFlags(0)
 
-	start, err := time.Parse("2006-01-02 15:04:05", "2017-09-09 12:14:00")
+	start := flag.String("start", "", "start of the measurement window")
+	end := flag.String("end", "", "end of the measurement window")
+	tz := flag.String("tz", "", "timezone to interpret start/end in: IANA name (Europe/Berlin) or fixed offset (+02:00); defaults to local time")
+	preferDMY := flag.Bool("dmy", false, "prefer day-month-year when start/end is ambiguous")
+	counts := flag.Int("counts", -1, "number of counts observed during the measurement window (-1 = not supplied)")
+	factor := flag.Float64("factor", 0, "conversion factor from CPM to dose rate")
+	flag.Parse()
+
+	loc, err := timeparse.ParseLocation(*tz)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	end, err := time.Parse("2006-01-02 15:04:05", "2017-09-09 12:29:02")
+	startTime, _, err := timeparse.Parse(*start, loc, *preferDMY)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	log.Printf("%v", end.Sub(start).Seconds())
-}
+	endTime, _, err := timeparse.Parse(*end, loc, *preferDMY)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	session := measure.NewSession(clock.SystemClock{})
+	session.Start(startTime)
+	session.End(endTime)
+
+	log.Printf("start: %v (local), %v (UTC)", startTime, startTime.UTC())
+	log.Printf("end:   %v (local), %v (UTC)", endTime, endTime.UTC())
+	log.Printf("duration: %v", session.Duration())
 
+	if *counts >= 0 {
+		log.Printf("CPM: %v", session.CPM(*counts))
+		if *factor > 0 {
+			log.Printf("dose rate: %v", session.DoseRate(*counts, *factor))
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package timeparse parses timestamps in a variety of common layouts so
+// that callers don't need to know in advance how a value is formatted.
+package timeparse
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// layouts is the set of fixed, unambiguous timestamp formats we try before
+// falling back to the locale-dependent numeric-date layouts.
+var layouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05",
+	"02.01.2006 15:04",
+	"2006 01 02",
+}
+
+// Parse tries a series of common layouts against s and returns the parsed
+// time along with the layout that matched. Unix seconds (10 digits) and
+// milliseconds (13 digits) given as bare numbers are recognized as well;
+// other bare numbers are not. When s is ambiguous between a
+// day-month-year and month-day-year reading (e.g. "01/02/2006"), preferDMY
+// selects which one is tried first.
+//
+// Layouts that don't carry their own zone offset (i.e. everything but
+// RFC3339) are interpreted in loc, mirroring time.ParseInLocation. A nil
+// loc is treated as time.Local.
+func Parse(s string, loc *time.Location, preferDMY bool) (time.Time, string, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	if t, layout, ok := parseUnix(s); ok {
+		return t.In(loc), layout, nil
+	}
+
+	for _, layout := range candidates(preferDMY) {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, layout, nil
+		}
+	}
+
+	return time.Time{}, "", fmt.Errorf("timeparse: could not parse %q with any known layout", s)
+}
+
+// ParseLocation resolves s into a *time.Location. s may be an IANA zone
+// name (e.g. "Europe/Berlin") or a fixed offset (e.g. "+02:00"). An empty
+// s resolves to time.Local.
+func ParseLocation(s string) (*time.Location, error) {
+	if s == "" {
+		return time.Local, nil
+	}
+
+	if loc, err := time.LoadLocation(s); err == nil {
+		return loc, nil
+	}
+
+	if offset, ok := parseFixedOffset(s); ok {
+		return time.FixedZone(s, offset), nil
+	}
+
+	return nil, fmt.Errorf("timeparse: unknown timezone %q", s)
+}
+
+// parseFixedOffset parses a "+02:00" / "-05:30" style offset into seconds
+// east of UTC.
+func parseFixedOffset(s string) (int, bool) {
+	if len(s) != 6 || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+		return 0, false
+	}
+
+	hours, err := strconv.Atoi(s[1:3])
+	if err != nil {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(s[4:6])
+	if err != nil {
+		return 0, false
+	}
+
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+func candidates(preferDMY bool) []string {
+	numeric := []string{"01/02/2006", "02/01/2006"}
+	if preferDMY {
+		numeric[0], numeric[1] = numeric[1], numeric[0]
+	}
+
+	all := make([]string, 0, len(layouts)+len(numeric))
+	all = append(all, layouts...)
+	all = append(all, numeric...)
+	return all
+}
+
+func parseUnix(s string) (time.Time, string, bool) {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+
+	switch len(s) {
+	case 10:
+		return time.Unix(sec, 0), "unix-seconds", true
+	case 13:
+		return time.Unix(0, sec*int64(time.Millisecond)), "unix-millis", true
+	default:
+		return time.Time{}, "", false
+	}
+}
@@ -0,0 +1,146 @@
+package timeparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		preferDMY bool
+		want      time.Time
+		wantErr   bool
+	}{
+		{
+			name:  "rfc3339",
+			input: "2017-09-09T12:14:00Z",
+			want:  time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC),
+		},
+		{
+			name:  "space separated",
+			input: "2017-09-09 12:14:00",
+			want:  time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC),
+		},
+		{
+			name:  "slash separated date and time",
+			input: "2017/09/09 12:14:00",
+			want:  time.Date(2017, 9, 9, 12, 14, 0, 0, time.UTC),
+		},
+		{
+			name:  "dotted date with minutes only",
+			input: "09.07.2017 12:14",
+			want:  time.Date(2017, 7, 9, 12, 14, 0, 0, time.UTC),
+		},
+		{
+			name:  "bare date",
+			input: "2016 07 25",
+			want:  time.Date(2016, 7, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "unix seconds",
+			input: "1504959240",
+			want:  time.Unix(1504959240, 0),
+		},
+		{
+			name:  "unix millis",
+			input: "1504959240000",
+			want:  time.Unix(1504959240, 0),
+		},
+		{
+			name:      "ambiguous numeric date defaults to MDY",
+			input:     "01/02/2006",
+			preferDMY: false,
+			want:      time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "ambiguous numeric date with PreferDMY",
+			input:     "01/02/2006",
+			preferDMY: true,
+			want:      time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "unparseable",
+			input:   "not a timestamp",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := Parse(tt.input, time.UTC, tt.preferDMY)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUsesGivenLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("tzdata not available: %v", err)
+	}
+
+	got, _, err := Parse("2017-09-09 12:14:00", berlin, false)
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+	if got.Location() != berlin {
+		t.Errorf("Parse() location = %v, want %v", got.Location(), berlin)
+	}
+
+	want := time.Date(2017, 9, 9, 10, 14, 0, 0, time.UTC)
+	if !got.UTC().Equal(want) {
+		t.Errorf("Parse() UTC = %v, want %v", got.UTC(), want)
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+		wantErr    bool
+	}{
+		{name: "empty defaults to local", input: "", wantOffset: localOffset(t)},
+		{name: "positive fixed offset", input: "+02:00", wantOffset: 2 * 3600},
+		{name: "negative fixed offset", input: "-05:30", wantOffset: -(5*3600 + 30*60)},
+		{name: "garbage", input: "not-a-zone", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := ParseLocation(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseLocation(%q) = %v, want error", tt.input, loc)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseLocation(%q) returned unexpected error: %v", tt.input, err)
+			}
+			_, offset := time.Now().In(loc).Zone()
+			if offset != tt.wantOffset {
+				t.Errorf("ParseLocation(%q) offset = %d, want %d", tt.input, offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func localOffset(t *testing.T) int {
+	t.Helper()
+	_, offset := time.Now().In(time.Local).Zone()
+	return offset
+}